@@ -23,6 +23,9 @@ const (
 // DefaultFormat ...
 var DefaultFormat = FormatFull
 
+// MaxStackDepth is the maximum number of stack frames captured per Error.
+var MaxStackDepth = 32
+
 // StripPath ...
 var StripPath = func(p string) string {
 	dirs := filepath.SplitList(os.Getenv("GOPATH"))
@@ -47,6 +50,86 @@ type Error struct {
 	File     string
 	Function string
 	Line     int
+
+	// Sentinel lets an *Error declare that it "is" a package-level sentinel
+	// (e.g. var ErrNotFound = errors.New("not found")) without setting
+	// Cause to it, so errors.Is(err, ErrNotFound) succeeds alongside
+	// errors.Is(err, someUnderlyingCause). Set it via WithSentinel.
+	Sentinel error
+
+	pcs []uintptr
+}
+
+// Frame represents a single stack frame of an Error's stack trace.
+type Frame struct {
+	File     string
+	Line     int
+	Function string
+}
+
+// Format implements fmt.Formatter, printing "file:line" for %s and
+// "function\n\tfile:line" for %+v.
+func (f Frame) Format(s fmt.State, c rune) {
+	switch {
+	case c == 'v' && s.Flag('+'):
+		fmt.Fprintf(s, "%s\n\t%s:%d", f.Function, f.File, f.Line)
+	default:
+		fmt.Fprintf(s, "%s:%d", f.File, f.Line)
+	}
+}
+
+// StackTrace lazily resolves the captured program counters into Frames.
+func (e *Error) StackTrace() []Frame {
+	if len(e.pcs) == 0 {
+		return nil
+	}
+
+	frames := runtime.CallersFrames(e.pcs)
+	result := make([]Frame, 0, len(e.pcs))
+
+	for {
+		frame, more := frames.Next()
+
+		result = append(result, Frame{
+			File:     StripPath(frame.File),
+			Line:     frame.Line,
+			Function: cleanFuncName(frame.Function),
+		})
+
+		if !more {
+			break
+		}
+	}
+
+	return result
+}
+
+// cleanFuncName trims a fully-qualified runtime function name down to
+// "Receiver.MethodName" / "FuncName".
+func cleanFuncName(funcName string) string {
+	// - "github.com/palantir/shield/package.FuncName"
+	// - "github.com/palantir/shield/package.Receiver.MethodName"
+	// - "github.com/palantir/shield/package.(*PtrReceiver).MethodName"
+	funcName = funcName[strings.LastIndex(funcName, "/")+1:]
+	funcName = funcName[strings.Index(funcName, ".")+1:]
+	funcName = strings.Replace(funcName, "(", "", 1)
+	funcName = strings.Replace(funcName, "*", "", 1)
+	funcName = strings.Replace(funcName, ")", "", 1)
+
+	return funcName
+}
+
+// commonStackSuffix returns the number of trailing frames that frames and
+// other have in common, so a cause doesn't repeat the frames already
+// printed for the Error wrapping it when the full stack trace is printed.
+func commonStackSuffix(frames, other []Frame) int {
+	n := 0
+
+	for n < len(frames) && n < len(other) && frames[len(frames)-1-n] == other[len(other)-1-n] {
+		n++
+	}
+
+	return n
 }
 
 // New ...
@@ -63,6 +146,59 @@ func Propagate(cause error, format string, args ...interface{}) error {
 	return Create(cause, format, args...)
 }
 
+// Wrap attaches a stack trace to cause without adding a message. Useful at
+// API boundaries where a stdlib or third-party error (e.g. *fs.PathError)
+// bubbles up and just needs a location, not a new sentence.
+func Wrap(cause error) error {
+	if cause == nil {
+		return nil
+	}
+
+	return Create(cause, "")
+}
+
+// WrapMessage attaches a stack trace and a plain message to cause, without
+// the printf overhead of Propagate.
+func WrapMessage(cause error, msg string) error {
+	if cause == nil {
+		return nil
+	}
+
+	return withCapturedStack(&Error{Message: msg, Cause: cause}, 3)
+}
+
+// WithStack attaches a stack trace to err. It is idempotent: if err is
+// already an *Error carrying a stack trace, it is returned unchanged.
+func WithStack(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if e, ok := err.(*Error); ok && len(e.pcs) > 0 {
+		return e
+	}
+
+	return withCapturedStack(&Error{Cause: err}, 3)
+}
+
+// WithSentinel sets err's Sentinel to sentinel and returns err, so that
+// errors.Is(err, sentinel) succeeds even though Cause points elsewhere. If
+// err is not an *Error, it is returned unchanged.
+func WithSentinel(err error, sentinel error) error {
+	if e, ok := err.(*Error); ok {
+		e.Sentinel = sentinel
+	}
+
+	return err
+}
+
+// Is reports whether target is e.Sentinel, letting e stand in for a
+// package-level sentinel error in addition to its actual Cause. When this
+// returns false, the package-level Is goes on to walk Cause.
+func (e *Error) Is(target error) bool {
+	return e.Sentinel != nil && e.Sentinel == target
+}
+
 // Cause ...
 func Cause(err error) error {
 	if err, ok := err.(*Error); ok {
@@ -81,29 +217,24 @@ func Create(cause error, format string, args ...interface{}) error {
 		Cause:   cause,
 	}
 
-	pc, file, line, ok := runtime.Caller(2)
-	if !ok {
-		return err
-	}
-
-	err.File = StripPath(file)
-	err.Line = line
+	return withCapturedStack(err, 4)
+}
 
-	fn := runtime.FuncForPC(pc)
-	if fn == nil {
+// withCapturedStack captures the current call stack into err.pcs, skipping
+// skip frames (as in runtime.Callers), and populates the top-frame
+// convenience fields from it.
+func withCapturedStack(err *Error, skip int) *Error {
+	pcs := make([]uintptr, MaxStackDepth)
+	n := runtime.Callers(skip, pcs)
+	if n == 0 {
 		return err
 	}
+	err.pcs = pcs[:n]
 
-	// - "github.com/palantir/shield/package.FuncName"
-	// - "github.com/palantir/shield/package.Receiver.MethodName"
-	// - "github.com/palantir/shield/package.(*PtrReceiver).MethodName"
-	funcName := fn.Name()
-	funcName = funcName[strings.LastIndex(funcName, "/")+1:]
-	funcName = funcName[strings.Index(funcName, ".")+1:]
-	funcName = strings.Replace(funcName, "(", "", 1)
-	funcName = strings.Replace(funcName, "*", "", 1)
-	funcName = strings.Replace(funcName, ")", "", 1)
-	err.Function = funcName
+	top := err.StackTrace()[0]
+	err.File = top.File
+	err.Line = top.Line
+	err.Function = top.Function
 
 	return err
 }
@@ -139,6 +270,16 @@ func Is(err, target error) bool {
 			return true
 		}
 
+		if multi, ok := err.(interface{ Unwrap() []error }); ok {
+			for _, child := range multi.Unwrap() {
+				if Is(child, target) {
+					return true
+				}
+			}
+
+			return false
+		}
+
 		if err = Unwrap(err); err == nil {
 			return false
 		}
@@ -173,6 +314,16 @@ func As(err error, target interface{}) bool {
 			return true
 		}
 
+		if multi, ok := err.(interface{ Unwrap() []error }); ok {
+			for _, child := range multi.Unwrap() {
+				if As(child, target) {
+					return true
+				}
+			}
+
+			return false
+		}
+
 		err = Unwrap(err)
 	}
 
@@ -191,6 +342,8 @@ func (e *Error) Format(f fmt.State, c rune) {
 		text = formatFull(e)
 	} else if f.Flag('#') && !f.Flag('+') && c == 's' { // "%#s"
 		text = formatShort(e)
+	} else if f.Flag('+') && c == 'v' { // "%+v"
+		text = formatFullStack(e)
 	} else if DefaultFormat == FormatFull {
 		text = formatFull(e)
 	} else {
@@ -252,6 +405,48 @@ func formatFull(e *Error) string {
 	return s
 }
 
+// formatFullStack is like formatFull, but renders the complete captured
+// stack trace (one frame per line, indented) for every Error in the chain
+// instead of just its top frame. Frames already printed for the Error
+// wrapping a cause are not repeated for that cause.
+func formatFullStack(e *Error) string {
+	s := ""
+
+	newline := func() {
+		if s != "" && !strings.HasSuffix(s, "\n") {
+			s += "\n"
+		}
+	}
+
+	var prevFrames []Frame
+
+	for curr, ok := e, true; ok; curr, ok = curr.Cause.(*Error) {
+		s += curr.Message
+
+		frames := curr.StackTrace()
+		skip := commonStackSuffix(frames, prevFrames)
+
+		for _, frame := range frames[:len(frames)-skip] {
+			newline()
+			s += fmt.Sprintf("\t%s (%s)", frame, frame.Function)
+		}
+
+		prevFrames = frames
+
+		if curr.Cause != nil {
+			newline()
+			if cause, ok := curr.Cause.(*Error); !ok {
+				s += "Caused by: "
+				s += curr.Cause.Error()
+			} else if cause.Message != "" {
+				s += "Caused by: "
+			}
+		}
+	}
+
+	return s
+}
+
 // formatShort ...
 func formatShort(e *Error) string {
 	s := ""
@@ -36,6 +36,83 @@ Caused by: error from f3
 	}
 }
 
+func TestStackTrace(t *testing.T) {
+	f1 := func() error {
+		return errors.New("error from f1")
+	}
+	f2 := func() error {
+		return errors.Propagate(f1(), "error from f2")
+	}
+
+	err := f2().(*errors.Error)
+
+	top := err.StackTrace()
+	if len(top) == 0 {
+		t.Fatalf("StackTrace: got empty stack, expected at least one frame")
+	}
+	if top[0].File != err.File || top[0].Line != err.Line || top[0].Function != err.Function {
+		t.Errorf("StackTrace: top frame %+v does not match convenience fields %s:%d (%s)", top[0], err.File, err.Line, err.Function)
+	}
+
+	full := fmt.Sprintf("%+v", err)
+	if !strings.Contains(full, "error from f2") || !strings.Contains(full, "error from f1") {
+		t.Errorf("%%+v: got %q, expected it to contain both messages", full)
+	}
+}
+
+func TestWrap(t *testing.T) {
+	pathErr := &fs.PathError{Op: "open", Path: "/tmp/x", Err: os.ErrNotExist}
+
+	err := errors.Wrap(pathErr)
+	if got := fmt.Sprint(err); !strings.Contains(got, pathErr.Error()) {
+		t.Errorf("Wrap: got %q, expected it to contain %q", got, pathErr.Error())
+	}
+	if errors.Cause(err) != pathErr {
+		t.Errorf("Wrap: Cause(err) = %v, expected %v", errors.Cause(err), pathErr)
+	}
+
+	if errors.Wrap(nil) != nil {
+		t.Errorf("Wrap(nil): expected nil")
+	}
+}
+
+func TestWrapMessage(t *testing.T) {
+	cause := errors.New("root cause")
+	err := errors.WrapMessage(cause, "while doing the thing")
+
+	if got := fmt.Sprint(err); !strings.Contains(got, "while doing the thing") || !strings.Contains(got, "root cause") {
+		t.Errorf("WrapMessage: got %q, expected it to contain both messages", got)
+	}
+
+	if errors.WrapMessage(nil, "x") != nil {
+		t.Errorf("WrapMessage(nil, ...): expected nil")
+	}
+}
+
+func TestWithStack(t *testing.T) {
+	plain := fmt.Errorf("plain")
+	wrapped := errors.WithStack(plain)
+
+	e, ok := wrapped.(*errors.Error)
+	if !ok {
+		t.Fatalf("WithStack: got %T, expected *errors.Error", wrapped)
+	}
+	if len(e.StackTrace()) == 0 {
+		t.Errorf("WithStack: expected a non-empty stack trace")
+	}
+	if e.Function != "TestWithStack" {
+		t.Errorf("WithStack: top frame = %s:%d (%s), expected it to point at the caller, not errors.go", e.File, e.Line, e.Function)
+	}
+
+	if again := errors.WithStack(wrapped); again != wrapped {
+		t.Errorf("WithStack: expected an *Error with a stack to be returned unchanged")
+	}
+
+	if errors.WithStack(nil) != nil {
+		t.Errorf("WithStack(nil): expected nil")
+	}
+}
+
 func TestUnwrap(t *testing.T) {
 	error1 := errors.New("error1")
 	error2 := errors.New("error2")
@@ -85,6 +162,25 @@ func TestIs(t *testing.T) {
 	}
 }
 
+func TestWithSentinel(t *testing.T) {
+	var errNotFound = errors.New("not found")
+
+	dbErr := errors.New("no rows")
+	err := errors.WithSentinel(errors.Propagate(dbErr, "user lookup"), errNotFound)
+
+	if !errors.Is(err, errNotFound) {
+		t.Errorf("errors.Is(err, errNotFound): got false, expected true")
+	}
+	if !errors.Is(err, dbErr) {
+		t.Errorf("errors.Is(err, dbErr): got false, expected true")
+	}
+
+	unrelated := errors.New("unrelated")
+	if errors.Is(err, unrelated) {
+		t.Errorf("errors.Is(err, unrelated): got true, expected false")
+	}
+}
+
 func TestAs(t *testing.T) {
 	error1 := &fs.PathError{Op: "readdir", Path: "error1", Err: errors.New("root-error")}
 	error2 := errors.Propagate(error1, "error2")
@@ -0,0 +1,34 @@
+package errors
+
+// Find walks the cause chain of err (via Unwrap), returning the first error
+// for which predicate returns true, or nil if none match. Unlike As, it
+// hands back the error itself rather than assigning into a target, which is
+// handy when the caller wants to inspect a match rather than type-switch on
+// it.
+func Find(err error, predicate func(error) bool) error {
+	for err != nil {
+		if predicate(err) {
+			return err
+		}
+
+		err = Unwrap(err)
+	}
+
+	return nil
+}
+
+// FindAs is the generic counterpart to Find: it walks the cause chain of err
+// and returns the first error assignable to T, along with true. If no such
+// error is found, it returns the zero value of T and false.
+func FindAs[T error](err error) (T, bool) {
+	for err != nil {
+		if t, ok := err.(T); ok {
+			return t, true
+		}
+
+		err = Unwrap(err)
+	}
+
+	var zero T
+	return zero, false
+}
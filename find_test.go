@@ -0,0 +1,48 @@
+package errors_test
+
+import (
+	"testing"
+
+	"github.com/tzvetkoff-go/errors"
+)
+
+type retryableError struct {
+	After int
+}
+
+func (e *retryableError) Error() string {
+	return "retry me"
+}
+
+func TestFind(t *testing.T) {
+	retryable := &retryableError{After: 5}
+	err := errors.Propagate(errors.Wrap(retryable), "request failed")
+
+	found := errors.Find(err, func(err error) bool {
+		_, ok := err.(*retryableError)
+		return ok
+	})
+	if found != retryable {
+		t.Errorf("Find: got %v, expected %v", found, retryable)
+	}
+
+	notFound := errors.Find(err, func(err error) bool { return false })
+	if notFound != nil {
+		t.Errorf("Find: got %v, expected nil", notFound)
+	}
+}
+
+func TestFindAs(t *testing.T) {
+	retryable := &retryableError{After: 5}
+	err := errors.Propagate(errors.Wrap(retryable), "request failed")
+
+	found, ok := errors.FindAs[*retryableError](err)
+	if !ok || found != retryable {
+		t.Errorf("FindAs: got (%v, %v), expected (%v, true)", found, ok, retryable)
+	}
+
+	_, ok = errors.FindAs[*retryableError](errors.New("unrelated"))
+	if ok {
+		t.Errorf("FindAs: expected no match")
+	}
+}
@@ -0,0 +1,106 @@
+package errors
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MultiError aggregates multiple errors, produced by Join.
+type MultiError struct {
+	Errors []error
+}
+
+// Join returns a *MultiError wrapping every non-nil error in errs, or nil if
+// none of them are non-nil. Unwrap() []error lets errors.Is/errors.As (and
+// the stdlib's) traverse every branch.
+func Join(errs ...error) error {
+	var joined []error
+	for _, err := range errs {
+		if err != nil {
+			joined = append(joined, err)
+		}
+	}
+
+	if len(joined) == 0 {
+		return nil
+	}
+
+	return &MultiError{Errors: joined}
+}
+
+// Unwrap returns every wrapped error, per the Go 1.20+ multi-error
+// convention.
+func (m *MultiError) Unwrap() []error {
+	return m.Errors
+}
+
+// Error ...
+func (m *MultiError) Error() string {
+	return fmt.Sprint(m)
+}
+
+// Format ...
+func (m *MultiError) Format(f fmt.State, c rune) {
+	var text string
+	if f.Flag('+') && !f.Flag('#') && (c == 's' || c == 'v') { // "%+s" / "%+v"
+		text = m.formatFull()
+	} else if f.Flag('#') && !f.Flag('+') && c == 's' { // "%#s"
+		text = m.formatShort()
+	} else if DefaultFormat == FormatFull {
+		text = m.formatFull()
+	} else {
+		text = m.formatShort()
+	}
+
+	formatString := "%"
+	for _, flag := range "-+# 0" {
+		if f.Flag(int(flag)) {
+			formatString += string(flag)
+		}
+	}
+	if width, has := f.Width(); has {
+		formatString += fmt.Sprint(width)
+	}
+	if precision, has := f.Precision(); has {
+		formatString += "."
+		formatString += fmt.Sprint(precision)
+	}
+	formatString += string(c)
+
+	fmt.Fprintf(f, formatString, text)
+}
+
+// formatFull prints each child on its own numbered "[n]" header, with its
+// full stack trace indented underneath.
+func (m *MultiError) formatFull() string {
+	lines := make([]string, len(m.Errors))
+	for i, err := range m.Errors {
+		header := fmt.Sprintf("[%d] ", i+1)
+
+		var body string
+		if e, ok := err.(*Error); ok {
+			body = fmt.Sprintf("%+v", e)
+		} else {
+			body = err.Error()
+		}
+
+		indented := strings.ReplaceAll(body, "\n", "\n"+strings.Repeat(" ", len(header)))
+		lines[i] = header + indented
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// formatShort joins every child's short message with "; ".
+func (m *MultiError) formatShort() string {
+	parts := make([]string, len(m.Errors))
+	for i, err := range m.Errors {
+		if e, ok := err.(*Error); ok {
+			parts[i] = fmt.Sprintf("%#s", e)
+		} else {
+			parts[i] = err.Error()
+		}
+	}
+
+	return strings.Join(parts, "; ")
+}
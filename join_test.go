@@ -0,0 +1,58 @@
+package errors_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/tzvetkoff-go/errors"
+)
+
+func TestJoin(t *testing.T) {
+	if errors.Join() != nil {
+		t.Errorf("Join(): expected nil")
+	}
+	if errors.Join(nil, nil) != nil {
+		t.Errorf("Join(nil, nil): expected nil")
+	}
+
+	err1 := errors.New("error1")
+	err2 := errors.New("error2")
+
+	joined := errors.Join(err1, nil, err2)
+
+	if !errors.Is(joined, err1) {
+		t.Errorf("errors.Is(joined, err1): got false, expected true")
+	}
+	if !errors.Is(joined, err2) {
+		t.Errorf("errors.Is(joined, err2): got false, expected true")
+	}
+
+	short := fmt.Sprintf("%#s", joined)
+	if !strings.Contains(short, "; ") {
+		t.Errorf("%%#s: got %q, expected errors joined with \"; \"", short)
+	}
+
+	full := fmt.Sprintf("%+v", joined)
+	if !strings.Contains(full, "[1] ") || !strings.Contains(full, "[2] ") {
+		t.Errorf("%%+v: got %q, expected numbered [n] headers", full)
+	}
+
+	if errors.Cause(joined) != joined {
+		t.Errorf("Cause(joined): expected the MultiError itself, got %v", errors.Cause(joined))
+	}
+}
+
+func TestJoinAs(t *testing.T) {
+	pathErr := &osPathError{}
+	joined := errors.Join(errors.New("error1"), pathErr)
+
+	var target *osPathError
+	if !errors.As(joined, &target) {
+		t.Errorf("errors.As(joined, &target): got false, expected true")
+	}
+}
+
+type osPathError struct{}
+
+func (e *osPathError) Error() string { return "path error" }
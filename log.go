@@ -0,0 +1,80 @@
+package errors
+
+import (
+	"encoding/json"
+	"log/slog"
+)
+
+// LogValue implements slog.LogValuer, rendering the Error as a group of
+// structured attributes (message, file, line, function, stack and cause)
+// instead of the flat string produced by Error(). An *Error cause becomes a
+// nested group; any other cause is its Error() string.
+func (e *Error) LogValue() slog.Value {
+	attrs := []slog.Attr{
+		slog.String("message", e.Message),
+		slog.String("file", e.File),
+		slog.Int("line", e.Line),
+		slog.String("function", e.Function),
+	}
+
+	if stack := e.StackTrace(); len(stack) > 0 {
+		frames := make([]any, len(stack))
+		for i, frame := range stack {
+			frames[i] = map[string]any{
+				"file":     frame.File,
+				"line":     frame.Line,
+				"function": frame.Function,
+			}
+		}
+		attrs = append(attrs, slog.Any("stack", frames))
+	}
+
+	if e.Cause != nil {
+		if cause, ok := e.Cause.(*Error); ok {
+			attrs = append(attrs, slog.Any("cause", cause.LogValue()))
+		} else {
+			attrs = append(attrs, slog.String("cause", e.Cause.Error()))
+		}
+	}
+
+	return slog.GroupValue(attrs...)
+}
+
+// Fields returns the Error as a plain map, for callers that want structured
+// logging (logrus, zap, ...) without going through slog.
+func (e *Error) Fields() map[string]any {
+	fields := map[string]any{
+		"message":  e.Message,
+		"file":     e.File,
+		"line":     e.Line,
+		"function": e.Function,
+	}
+
+	if stack := e.StackTrace(); len(stack) > 0 {
+		frames := make([]map[string]any, len(stack))
+		for i, frame := range stack {
+			frames[i] = map[string]any{
+				"file":     frame.File,
+				"line":     frame.Line,
+				"function": frame.Function,
+			}
+		}
+		fields["stack"] = frames
+	}
+
+	if e.Cause != nil {
+		if cause, ok := e.Cause.(*Error); ok {
+			fields["cause"] = cause.Fields()
+		} else {
+			fields["cause"] = e.Cause.Error()
+		}
+	}
+
+	return fields
+}
+
+// MarshalJSON implements json.Marshaler, producing the same shape as
+// Fields().
+func (e *Error) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.Fields())
+}
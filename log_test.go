@@ -0,0 +1,65 @@
+package errors_test
+
+import (
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/tzvetkoff-go/errors"
+)
+
+func TestFields(t *testing.T) {
+	err := errors.Propagate(errors.New("root cause"), "request failed").(*errors.Error)
+
+	fields := err.Fields()
+	if fields["message"] != "request failed" {
+		t.Errorf("Fields: message = %v, expected %q", fields["message"], "request failed")
+	}
+
+	cause, ok := fields["cause"].(map[string]any)
+	if !ok {
+		t.Fatalf("Fields: cause = %T, expected map[string]any", fields["cause"])
+	}
+	if cause["message"] != "root cause" {
+		t.Errorf("Fields: cause[message] = %v, expected %q", cause["message"], "root cause")
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	err := errors.Propagate(errors.New("root cause"), "request failed")
+
+	data, marshalErr := json.Marshal(err)
+	if marshalErr != nil {
+		t.Fatalf("MarshalJSON: %v", marshalErr)
+	}
+
+	var decoded map[string]any
+	if unmarshalErr := json.Unmarshal(data, &decoded); unmarshalErr != nil {
+		t.Fatalf("json.Unmarshal: %v", unmarshalErr)
+	}
+	if decoded["message"] != "request failed" {
+		t.Errorf("MarshalJSON: message = %v, expected %q", decoded["message"], "request failed")
+	}
+}
+
+func TestLogValue(t *testing.T) {
+	err := errors.Propagate(errors.New("root cause"), "request failed").(*errors.Error)
+
+	value := err.LogValue()
+	if value.Kind() != slog.KindGroup {
+		t.Fatalf("LogValue: kind = %v, expected %v", value.Kind(), slog.KindGroup)
+	}
+
+	var messageFound bool
+	for _, attr := range value.Group() {
+		if attr.Key == "message" {
+			messageFound = true
+			if attr.Value.String() != "request failed" {
+				t.Errorf("LogValue: message = %v, expected %q", attr.Value, "request failed")
+			}
+		}
+	}
+	if !messageFound {
+		t.Errorf("LogValue: no \"message\" attribute found")
+	}
+}